@@ -2,60 +2,150 @@ package cmd
 
 import (
 	"fmt"
-	"github.com/spf13/cobra"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/volodimyr/vikinGo/cli_task_manager/task/store"
 )
 
 func init() {
+	rootCmd.PersistentFlags().StringVar(&dbPath, "db", defaultDBPath(), "path to the tasks BoltDB file")
 	rootCmd.AddCommand(do, add, list, rm, completed)
 }
 
+// dbPath is the BoltDB file the commands persist tasks to, overridable
+// via --db on rootCmd.
+var dbPath string
+
+// taskStore is opened once per invocation in rootCmd's PersistentPreRunE
+// and shared by every subcommand's Run.
+var taskStore store.Store
+
+func defaultDBPath() string {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		dataHome = filepath.Join(os.Getenv("HOME"), ".local", "share")
+	}
+	return filepath.Join(dataHome, "vikinGo", "tasks.db")
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "task_manager",
 	Short: "To do list. Make your life easier.",
 	Long:  `Be cool to organize your life with this application. Add, delete, list and remove your daily routine tasks.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
+			return fmt.Errorf("creating db directory: %w", err)
+		}
+		s, err := store.Open(dbPath)
+		if err != nil {
+			return fmt.Errorf("opening db: %w", err)
+		}
+		taskStore = s
+		return nil
+	},
+	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+		if taskStore == nil {
+			return nil
+		}
+		return taskStore.Close()
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		// some code
+		cmd.Help()
 	},
 }
 
 var do = &cobra.Command{
-	Use:   "do",
+	Use:   "do <id>",
 	Short: "Mark a task on your TODO list as complete",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("Doing your task...")
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid task id %q: %w", args[0], err)
+		}
+		if err := taskStore.Do(id); err != nil {
+			return err
+		}
+		fmt.Printf("Marked task %d as done.\n", id)
+		return nil
 	},
 }
 
 var add = &cobra.Command{
-	Use:   "add",
+	Use:   "add <title...>",
 	Short: "Add a new task to your TODO list",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("Adding your task...")
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		title := strings.Join(args, " ")
+		id, err := taskStore.Add(title)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Added task %d: %s\n", id, title)
+		return nil
 	},
 }
 
 var list = &cobra.Command{
 	Use:   "list",
 	Short: "List all of your incomplete tasks",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("Listing your tasks...")
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks, err := taskStore.Pending()
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			fmt.Println("No pending tasks.")
+			return nil
+		}
+		for _, t := range tasks {
+			fmt.Printf("%d: %s\n", t.ID, t.Title)
+		}
+		return nil
 	},
 }
 
 var completed = &cobra.Command{
 	Use:   "completed",
 	Short: "List all completed tasks today",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("List of completed tasks...")
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks, err := taskStore.CompletedOn(time.Now())
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			fmt.Println("No tasks completed today.")
+			return nil
+		}
+		for _, t := range tasks {
+			fmt.Printf("%d: %s (completed %s)\n", t.ID, t.Title, t.CompletedAt.Format(time.Kitchen))
+		}
+		return nil
 	},
 }
 
 var rm = &cobra.Command{
-	Use:   "remove",
+	Use:   "remove <id>",
 	Short: "Remove your daily routine task",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("Removing your task...")
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid task id %q: %w", args[0], err)
+		}
+		if err := taskStore.Remove(id); err != nil {
+			return err
+		}
+		fmt.Printf("Removed task %d.\n", id)
+		return nil
 	},
 }
 
@@ -64,4 +154,4 @@ func Execute() {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,149 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+var (
+	pendingBucket   = []byte("pending")
+	completedBucket = []byte("completed")
+)
+
+// BoltStore persists tasks to a BoltDB file, keeping pending and
+// completed tasks in their own buckets.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// Open opens (creating if necessary) the BoltDB file at path and
+// ensures the pending/completed buckets exist.
+func Open(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(pendingBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(completedBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltStore) Add(title string) (uint64, error) {
+	var id uint64
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(pendingBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		id = seq
+
+		t := Task{ID: id, Title: title}
+		data, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(id), data)
+	})
+	return id, err
+}
+
+func (s *BoltStore) Pending() ([]Task, error) {
+	var tasks []Task
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(pendingBucket).ForEach(func(k, v []byte) error {
+			var t Task
+			if err := json.Unmarshal(v, &t); err != nil {
+				return err
+			}
+			tasks = append(tasks, t)
+			return nil
+		})
+	})
+	return tasks, err
+}
+
+func (s *BoltStore) Do(id uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		pending := tx.Bucket(pendingBucket)
+		key := itob(id)
+		data := pending.Get(key)
+		if data == nil {
+			return fmt.Errorf("no pending task with id %d", id)
+		}
+
+		var t Task
+		if err := json.Unmarshal(data, &t); err != nil {
+			return err
+		}
+		t.CompletedAt = time.Now()
+
+		done, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(completedBucket).Put(key, done); err != nil {
+			return err
+		}
+		return pending.Delete(key)
+	})
+}
+
+func (s *BoltStore) CompletedOn(day time.Time) ([]Task, error) {
+	var tasks []Task
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(completedBucket).ForEach(func(k, v []byte) error {
+			var t Task
+			if err := json.Unmarshal(v, &t); err != nil {
+				return err
+			}
+			if sameDay(t.CompletedAt, day) {
+				tasks = append(tasks, t)
+			}
+			return nil
+		})
+	})
+	return tasks, err
+}
+
+func (s *BoltStore) Remove(id uint64) error {
+	key := itob(id)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(pendingBucket).Delete(key); err != nil {
+			return err
+		}
+		return tx.Bucket(completedBucket).Delete(key)
+	})
+}
+
+func itob(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return b
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,66 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openTestBoltStore(t *testing.T) *BoltStore {
+	t.Helper()
+	s, err := Open(filepath.Join(t.TempDir(), "tasks.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestBoltStoreAddDoRemove(t *testing.T) {
+	s := openTestBoltStore(t)
+
+	id, err := s.Add("buy milk")
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	pending, err := s.Pending()
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if len(pending) != 1 || pending[0].ID != id {
+		t.Fatalf("Pending() = %v, want a single task with id %d", pending, id)
+	}
+
+	if err := s.Do(id); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	pending, _ = s.Pending()
+	if len(pending) != 0 {
+		t.Fatalf("Pending() after Do = %v, want empty", pending)
+	}
+
+	completed, err := s.CompletedOn(time.Now())
+	if err != nil {
+		t.Fatalf("CompletedOn: %v", err)
+	}
+	if len(completed) != 1 || completed[0].ID != id {
+		t.Fatalf("CompletedOn() = %v, want a single task with id %d", completed, id)
+	}
+
+	if err := s.Remove(id); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	completed, _ = s.CompletedOn(time.Now())
+	if len(completed) != 0 {
+		t.Fatalf("CompletedOn() after Remove = %v, want empty", completed)
+	}
+}
+
+func TestBoltStoreDoUnknownID(t *testing.T) {
+	s := openTestBoltStore(t)
+	if err := s.Do(42); err == nil {
+		t.Fatal("Do(42) on an empty store = nil error, want one reporting the missing task")
+	}
+}
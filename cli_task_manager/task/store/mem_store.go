@@ -0,0 +1,67 @@
+package store
+
+import (
+	"fmt"
+	"time"
+)
+
+// MemStore is an in-memory Store, useful for tests that exercise the
+// cmd package without touching a real BoltDB file.
+type MemStore struct {
+	nextID    uint64
+	pending   map[uint64]Task
+	completed map[uint64]Task
+}
+
+// NewMemStore returns an empty, ready-to-use MemStore.
+func NewMemStore() *MemStore {
+	return &MemStore{
+		pending:   make(map[uint64]Task),
+		completed: make(map[uint64]Task),
+	}
+}
+
+func (s *MemStore) Add(title string) (uint64, error) {
+	s.nextID++
+	s.pending[s.nextID] = Task{ID: s.nextID, Title: title}
+	return s.nextID, nil
+}
+
+func (s *MemStore) Pending() ([]Task, error) {
+	tasks := make([]Task, 0, len(s.pending))
+	for _, t := range s.pending {
+		tasks = append(tasks, t)
+	}
+	return tasks, nil
+}
+
+func (s *MemStore) Do(id uint64) error {
+	t, ok := s.pending[id]
+	if !ok {
+		return fmt.Errorf("no pending task with id %d", id)
+	}
+	t.CompletedAt = time.Now()
+	s.completed[id] = t
+	delete(s.pending, id)
+	return nil
+}
+
+func (s *MemStore) CompletedOn(day time.Time) ([]Task, error) {
+	var tasks []Task
+	for _, t := range s.completed {
+		if sameDay(t.CompletedAt, day) {
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks, nil
+}
+
+func (s *MemStore) Remove(id uint64) error {
+	delete(s.pending, id)
+	delete(s.completed, id)
+	return nil
+}
+
+func (s *MemStore) Close() error {
+	return nil
+}
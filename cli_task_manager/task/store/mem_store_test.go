@@ -0,0 +1,66 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemStoreAddAndPending(t *testing.T) {
+	s := NewMemStore()
+
+	id, err := s.Add("buy milk")
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	pending, err := s.Pending()
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if len(pending) != 1 || pending[0].ID != id || pending[0].Title != "buy milk" {
+		t.Fatalf("Pending() = %v, want a single %q task with id %d", pending, "buy milk", id)
+	}
+}
+
+func TestMemStoreDoMovesTaskToCompleted(t *testing.T) {
+	s := NewMemStore()
+	id, _ := s.Add("buy milk")
+
+	if err := s.Do(id); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	pending, _ := s.Pending()
+	if len(pending) != 0 {
+		t.Fatalf("Pending() after Do = %v, want empty", pending)
+	}
+
+	completed, err := s.CompletedOn(time.Now())
+	if err != nil {
+		t.Fatalf("CompletedOn: %v", err)
+	}
+	if len(completed) != 1 || completed[0].ID != id {
+		t.Fatalf("CompletedOn() = %v, want a single task with id %d", completed, id)
+	}
+}
+
+func TestMemStoreDoUnknownID(t *testing.T) {
+	s := NewMemStore()
+	if err := s.Do(42); err == nil {
+		t.Fatal("Do(42) on an empty store = nil error, want one reporting the missing task")
+	}
+}
+
+func TestMemStoreRemove(t *testing.T) {
+	s := NewMemStore()
+	id, _ := s.Add("buy milk")
+
+	if err := s.Remove(id); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+
+	pending, _ := s.Pending()
+	if len(pending) != 0 {
+		t.Fatalf("Pending() after Remove = %v, want empty", pending)
+	}
+}
@@ -0,0 +1,29 @@
+// Package store persists task_manager's tasks. The cobra commands in
+// cmd talk to the Store interface so a real BoltDB-backed store can be
+// swapped for an in-memory fake in tests.
+package store
+
+import "time"
+
+// Task is a single to-do item.
+type Task struct {
+	ID          uint64
+	Title       string
+	CompletedAt time.Time
+}
+
+// Store is the persistence boundary used by the task_manager commands.
+type Store interface {
+	// Add saves a new pending task and returns its assigned ID.
+	Add(title string) (uint64, error)
+	// Pending returns every task that hasn't been completed yet.
+	Pending() ([]Task, error)
+	// Do marks the pending task with id as complete.
+	Do(id uint64) error
+	// CompletedOn returns every task completed on the given day.
+	CompletedOn(day time.Time) ([]Task, error)
+	// Remove deletes id from both the pending and completed buckets.
+	Remove(id uint64) error
+	// Close releases the underlying resources.
+	Close() error
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/volodimyr/vikinGo/urlshort/v3/boltstore"
+)
+
+// GetBoltPaths loads every redirect currently stored in db. It's kept
+// around for BoltDBHandler's one-shot snapshot use case.
+func GetBoltPaths(db *bolt.DB) map[string]string {
+	store, err := boltstore.New(db)
+	if err != nil {
+		return nil
+	}
+	paths, err := store.List()
+	if err != nil {
+		return nil
+	}
+	return paths
+}
+
+// BoltCache is the in-memory snapshot the request-serving handler
+// reads from. The admin API refreshes it after every write so changes
+// made through it are visible immediately, without waiting on a
+// filesystem-driven reload.
+type BoltCache struct {
+	mu    sync.RWMutex
+	paths map[string]string
+}
+
+// NewBoltCache builds a BoltCache pre-loaded from store.
+func NewBoltCache(store *boltstore.Store) (*BoltCache, error) {
+	c := &BoltCache{}
+	return c, c.Refresh(store)
+}
+
+// Refresh reloads the cache from store.
+func (c *BoltCache) Refresh(store *boltstore.Store) error {
+	paths, err := store.List()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.paths = paths
+	c.mu.Unlock()
+	return nil
+}
+
+// Handler returns an http.HandlerFunc that redirects using the cached
+// paths, falling back to fallback when a path isn't found.
+func (c *BoltCache) Handler(fallback http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c.mu.RLock()
+		url, exist := c.paths[r.URL.Path]
+		c.mu.RUnlock()
+		if exist {
+			http.Redirect(w, r, url, http.StatusSeeOther)
+			return
+		}
+		fallback.ServeHTTP(w, r)
+	}
+}
+
+// AdminHandler returns the /_admin/redirects HTTP API backed by store,
+// refreshing cache on every write so the request handler sees changes
+// right away. When token is non-empty, requests must carry a matching
+// "Authorization: Bearer <token>" header.
+func AdminHandler(store *boltstore.Store, cache *BoltCache, token string) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/_admin/redirects", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		paths, err := store.List()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(paths)
+	})
+
+	mux.HandleFunc("/_admin/redirects/", func(w http.ResponseWriter, r *http.Request) {
+		path := "/" + strings.TrimPrefix(r.URL.Path, "/_admin/redirects/")
+		if path == "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			url, err := store.Get(path)
+			if errors.Is(err, boltstore.ErrNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode(struct {
+				URL string `json:"url"`
+			}{url})
+
+		case http.MethodPut:
+			var body struct {
+				URL string `json:"url"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if err := store.Put(path, body.URL); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if err := cache.Refresh(store); err != nil {
+				log.Printf("urlshort: admin write succeeded but cache refresh failed: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+		case http.MethodDelete:
+			if err := store.Delete(path); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if err := cache.Refresh(store); err != nil {
+				log.Printf("urlshort: admin delete succeeded but cache refresh failed: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	return requireBearerToken(token, mux)
+}
+
+// requireBearerToken wraps next with bearer-token auth. An empty token
+// disables the check, which is the default so local/dev use doesn't
+// need a flag.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/volodimyr/vikinGo/urlshort/v3/boltstore"
+)
+
+func newTestAdmin(t *testing.T, token string) (*boltstore.Store, http.Handler) {
+	t.Helper()
+	store, err := boltstore.Open(filepath.Join(t.TempDir(), "redirects.db"))
+	if err != nil {
+		t.Fatalf("boltstore.Open: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	cache, err := NewBoltCache(store)
+	if err != nil {
+		t.Fatalf("NewBoltCache: %v", err)
+	}
+	return store, AdminHandler(store, cache, token)
+}
+
+func TestAdminHandlerPutGetDelete(t *testing.T) {
+	_, h := newTestAdmin(t, "")
+
+	put := httptest.NewRequest(http.MethodPut, "/_admin/redirects/gh", strings.NewReader(`{"url":"https://github.com"}`))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, put)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("PUT /_admin/redirects/gh = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	get := httptest.NewRequest(http.MethodGet, "/_admin/redirects/gh", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, get)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /_admin/redirects/gh = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), "https://github.com") {
+		t.Fatalf("GET body = %q, want it to contain the stored url", rec.Body.String())
+	}
+
+	del := httptest.NewRequest(http.MethodDelete, "/_admin/redirects/gh", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, del)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("DELETE /_admin/redirects/gh = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	get = httptest.NewRequest(http.MethodGet, "/_admin/redirects/gh", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, get)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("GET /_admin/redirects/gh after delete = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestAdminHandlerGetDistinguishesNotFoundFromStorageError(t *testing.T) {
+	store, h := newTestAdmin(t, "")
+
+	missing := httptest.NewRequest(http.MethodGet, "/_admin/redirects/missing", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, missing)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("GET of a missing path = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// Closing the underlying db turns any further Get into a genuine
+	// storage failure, which must not be reported as a 404.
+	if err := store.Close(); err != nil {
+		t.Fatalf("store.Close: %v", err)
+	}
+
+	broken := httptest.NewRequest(http.MethodGet, "/_admin/redirects/gh", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, broken)
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("GET after the store is closed = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestAdminHandlerRequiresBearerToken(t *testing.T) {
+	_, h := newTestAdmin(t, "secret")
+
+	req := httptest.NewRequest(http.MethodGet, "/_admin/redirects", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("GET without a token = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/_admin/redirects", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET with the right token = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,94 @@
+// Package boltstore provides CRUD access to the redirects bucket in a
+// BoltDB file, shared by the admin HTTP API and the redirect cobra
+// commands so they agree on one storage format.
+package boltstore
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+var redirectsBucket = []byte("redirects")
+
+// ErrNotFound is returned by Get when path has no stored redirect, as
+// opposed to a storage/transaction failure, so callers can tell the
+// two apart.
+var ErrNotFound = errors.New("boltstore: no redirect for that path")
+
+// Store exposes CRUD operations over the redirects bucket in a BoltDB
+// file.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens (creating if necessary) the BoltDB file at path and
+// ensures the redirects bucket exists.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+
+	s, err := New(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// New wraps an already-open db, creating the redirects bucket if it
+// doesn't exist yet.
+func New(db *bolt.DB) (*Store, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(redirectsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) Put(path, url string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(redirectsBucket).Put([]byte(path), []byte(url))
+	})
+}
+
+func (s *Store) Get(path string) (string, error) {
+	var url string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(redirectsBucket).Get([]byte(path))
+		if v == nil {
+			return ErrNotFound
+		}
+		url = string(v)
+		return nil
+	})
+	return url, err
+}
+
+func (s *Store) Delete(path string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(redirectsBucket).Delete([]byte(path))
+	})
+}
+
+func (s *Store) List() (map[string]string, error) {
+	paths := make(map[string]string)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(redirectsBucket).ForEach(func(k, v []byte) error {
+			paths[string(k)] = string(v)
+			return nil
+		})
+	})
+	return paths, err
+}
@@ -0,0 +1,77 @@
+package boltstore
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	s, err := Open(filepath.Join(t.TempDir(), "redirects.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestStorePutGetList(t *testing.T) {
+	s := openTestStore(t)
+
+	if err := s.Put("/gh", "https://github.com"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	url, err := s.Get("/gh")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if url != "https://github.com" {
+		t.Fatalf("Get(/gh) = %q, want %q", url, "https://github.com")
+	}
+
+	paths, err := s.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if paths["/gh"] != "https://github.com" {
+		t.Fatalf("List() = %v, want it to contain /gh", paths)
+	}
+}
+
+func TestStoreGetMissing(t *testing.T) {
+	s := openTestStore(t)
+	_, err := s.Get("/missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get(/missing) = %v, want ErrNotFound", err)
+	}
+}
+
+func TestStoreDelete(t *testing.T) {
+	s := openTestStore(t)
+	if err := s.Put("/gh", "https://github.com"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := s.Delete("/gh"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get("/gh"); err == nil {
+		t.Fatal("Get(/gh) after Delete = nil error, want one reporting the missing path")
+	}
+}
+
+// TestOpenRejectsAlreadyLockedFile exercises the bolt.Options{Timeout}
+// fix: without it, this Open would block forever instead of failing.
+func TestOpenRejectsAlreadyLockedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.db")
+	first, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer first.Close()
+
+	if _, err := Open(path); err == nil {
+		t.Fatal("Open() on an already-locked file = nil error, want a timeout error")
+	}
+}
@@ -0,0 +1,110 @@
+// Package cmd provides the "vikinGo" cobra CLI for managing the
+// BoltDB-backed redirects used by the urlshort admin API. RedirectCmd
+// holds the "redirect" subcommands (add, list, rm, get) and is mounted
+// onto rootCmd in root.go; callers run the CLI via Execute.
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/volodimyr/vikinGo/urlshort/v3/boltstore"
+)
+
+// dbPath is the BoltDB file the redirect commands read/write,
+// overridable via --db.
+var dbPath string
+
+func init() {
+	RedirectCmd.PersistentFlags().StringVar(&dbPath, "db", "redirects.db", "path to the redirects BoltDB file")
+	RedirectCmd.AddCommand(redirectAdd, redirectList, redirectRm, redirectGet)
+}
+
+// RedirectCmd is the "redirect" command group: add, list, rm and get
+// subcommands that operate on the same redirects bucket the admin
+// HTTP API serves.
+var RedirectCmd = &cobra.Command{
+	Use:   "redirect",
+	Short: "Manage redirects stored in the BoltDB redirects bucket",
+}
+
+var redirectAdd = &cobra.Command{
+	Use:   "add <path> <url>",
+	Short: "Add or overwrite a redirect",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := boltstore.Open(dbPath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		if err := store.Put(args[0], args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("%s -> %s\n", args[0], args[1])
+		return nil
+	},
+}
+
+var redirectList = &cobra.Command{
+	Use:   "list",
+	Short: "List every stored redirect",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := boltstore.Open(dbPath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		paths, err := store.List()
+		if err != nil {
+			return err
+		}
+		for path, url := range paths {
+			fmt.Printf("%s -> %s\n", path, url)
+		}
+		return nil
+	},
+}
+
+var redirectGet = &cobra.Command{
+	Use:   "get <path>",
+	Short: "Print the URL a path redirects to",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := boltstore.Open(dbPath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		url, err := store.Get(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(url)
+		return nil
+	},
+}
+
+var redirectRm = &cobra.Command{
+	Use:   "rm <path>",
+	Short: "Remove a redirect",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := boltstore.Open(dbPath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		if err := store.Delete(args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("removed %s\n", args[0])
+		return nil
+	},
+}
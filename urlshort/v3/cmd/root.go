@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(RedirectCmd)
+}
+
+// rootCmd is the top-level "vikinGo" command that RedirectCmd mounts
+// onto, mirroring cli_task_manager/cmd's rootCmd/Execute pattern.
+var rootCmd = &cobra.Command{
+	Use:   "vikinGo",
+	Short: "Manage vikinGo's URL shortener redirects",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+// Execute runs the vikinGo root command, printing and exiting with
+// status 1 on error.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
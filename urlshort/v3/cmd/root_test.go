@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestRedirectCmdIsMountedOnRoot(t *testing.T) {
+	found := false
+	for _, c := range rootCmd.Commands() {
+		if c == RedirectCmd {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("RedirectCmd is not mounted on rootCmd, so \"vikinGo redirect ...\" is unreachable")
+	}
+}
+
+func TestRedirectAddListGetRm(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "redirects.db")
+
+	rootCmd.SetArgs([]string{"--db", dbFile, "redirect", "add", "/gh", "https://github.com"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("redirect add: %v", err)
+	}
+
+	rootCmd.SetArgs([]string{"--db", dbFile, "redirect", "get", "/gh"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("redirect get: %v", err)
+	}
+
+	rootCmd.SetArgs([]string{"--db", dbFile, "redirect", "list"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("redirect list: %v", err)
+	}
+
+	rootCmd.SetArgs([]string{"--db", dbFile, "redirect", "rm", "/gh"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("redirect rm: %v", err)
+	}
+
+	rootCmd.SetArgs([]string{"--db", dbFile, "redirect", "get", "/gh"})
+	if err := rootCmd.Execute(); err == nil {
+		t.Fatal("redirect get after rm = nil error, want one reporting the missing path")
+	}
+}
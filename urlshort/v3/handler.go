@@ -1,12 +1,14 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"path/filepath"
 
 	"github.com/boltdb/bolt"
-	yaml "gopkg.in/yaml.v2"
 )
 
 // MapHandler will return an http.HandlerFunc (which also
@@ -40,11 +42,43 @@ func MapHandler(pathsToUrls map[string]string, fallback http.Handler) http.Handl
 // The only errors that can be returned all related to having
 // invalid YAML data.
 //
+// Internally this delegates to the ordered redirects representation
+// (see ordered.go), so declaration order and "/*" prefix routes are
+// honored the same way OrderedHandler honors them.
+//
 // See MapHandler to create a similar http.HandlerFunc via
 // a mapping of paths to urls.
 func YAMLHandler(yml []byte, fallback http.Handler) (http.HandlerFunc, error) {
-	rs := redirects{}
-	err := yaml.Unmarshal(yml, &rs)
+	rs, err := parseOrderedYAML(yml)
+	if err != nil {
+		return nil, err
+	}
+
+	return OrderedHandler(rs, fallback), nil
+}
+
+// JSONHandler will parse the provided JSON and then return
+// an http.HandlerFunc (which also implements http.Handler)
+// that will attempt to map any paths to their corresponding
+// URL. If the path is not provided in the JSON, then the
+// fallback http.Handler will be called instead.
+//
+// JSON is expected to be in the format:
+//
+//     [
+//       {"path": "/some-path", "url": "https://www.some-url.com/demo"}
+//     ]
+//
+// A top-level object of the form {"redirects": [...]} is also
+// accepted, so callers can namespace the array if they want.
+//
+// The only errors that can be returned all related to having
+// invalid JSON data.
+//
+// See MapHandler to create a similar http.HandlerFunc via
+// a mapping of paths to urls.
+func JSONHandler(jsn []byte, fallback http.Handler) (http.HandlerFunc, error) {
+	rs, err := parseJSONRedirects(jsn)
 	if err != nil {
 		return nil, err
 	}
@@ -53,19 +87,106 @@ func YAMLHandler(yml []byte, fallback http.Handler) (http.HandlerFunc, error) {
 	return MapHandler(pathsToUrls, fallback), nil
 }
 
-func JSONHandler(jsn []byte, fallback http.Handler) (http.HandlerFunc, error) {
-	var rs struct {
+// JSONHandlerStrict behaves like JSONHandler but refuses to build a
+// handler out of malformed redirect data: a duplicate path or an empty
+// URL causes it to return a *JSONValidationError listing every
+// offending entry instead of silently letting the last one win.
+func JSONHandlerStrict(jsn []byte, fallback http.Handler) (http.HandlerFunc, error) {
+	rs, err := parseJSONRedirects(jsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if verr := validateRedirects(rs); verr != nil {
+		return nil, verr
+	}
+
+	pathsToUrls := buildMap(rs)
+	return MapHandler(pathsToUrls, fallback), nil
+}
+
+// parseJSONRedirects unmarshals jsn into a redirects slice, accepting
+// a bare JSON array, an object wrapping it under "redirects", or the
+// legacy single {"path":"...","url":"..."} shape JSONHandler
+// originally supported. The bare array is decoded with plain
+// json.Unmarshal, so unknown fields inside its entries are ignored;
+// the two object shapes are tried with DisallowUnknownFields so a
+// value that doesn't actually match one (e.g. a single-redirect object
+// decoded against the "redirects" wrapper) surfaces as an error
+// instead of silently producing zero redirects.
+func parseJSONRedirects(jsn []byte) (redirects, error) {
+	var rs redirects
+	if err := json.Unmarshal(jsn, &rs); err == nil {
+		return rs, nil
+	}
+
+	var wrapped struct {
+		Redirects redirects `json:"redirects"`
+	}
+	wrappedDec := json.NewDecoder(bytes.NewReader(jsn))
+	wrappedDec.DisallowUnknownFields()
+	if err := wrappedDec.Decode(&wrapped); err == nil {
+		return wrapped.Redirects, nil
+	}
+
+	var single struct {
 		Path string `json:"path"`
 		URL  string `json:"url"`
 	}
-	err := json.Unmarshal(jsn, &rs)
+	singleDec := json.NewDecoder(bytes.NewReader(jsn))
+	singleDec.DisallowUnknownFields()
+	if err := singleDec.Decode(&single); err != nil {
+		return nil, fmt.Errorf("redirects: unrecognized JSON shape: %w", err)
+	}
+	return redirects{{Path: single.Path, URL: single.URL}}, nil
+}
+
+// JSONValidationError reports every redirect entry that failed
+// validation in JSONHandlerStrict.
+type JSONValidationError struct {
+	Issues []string
+}
+
+func (e *JSONValidationError) Error() string {
+	return fmt.Sprintf("invalid redirects: %v", e.Issues)
+}
+
+func validateRedirects(rs redirects) error {
+	var issues []string
+	seen := make(map[string]bool, len(rs))
+	for _, r := range rs {
+		if r.URL == "" {
+			issues = append(issues, fmt.Sprintf("path %q has an empty url", r.Path))
+		}
+		if seen[r.Path] {
+			issues = append(issues, fmt.Sprintf("path %q is duplicated", r.Path))
+		}
+		seen[r.Path] = true
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+	return &JSONValidationError{Issues: issues}
+}
+
+// LoaderFromFile reads the redirect file at path and builds a handler
+// for it, dispatching on the file extension (.json, .yaml or .yml) so
+// callers don't have to branch on format themselves.
+func LoaderFromFile(path string, fallback http.Handler) (http.HandlerFunc, error) {
+	data, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	pathsToUrls := make(map[string]string)
-	pathsToUrls[rs.Path] = rs.URL
-	return MapHandler(pathsToUrls, fallback), nil
+	switch filepath.Ext(path) {
+	case ".json":
+		return JSONHandler(data, fallback)
+	case ".yaml", ".yml":
+		return YAMLHandler(data, fallback)
+	default:
+		return nil, fmt.Errorf("unsupported redirect file extension: %s", filepath.Ext(path))
+	}
 }
 
 func BoltDBHandler(db *bolt.DB, fallback http.Handler) (http.HandlerFunc, error) {
@@ -87,6 +208,6 @@ func buildMap(rs redirects) map[string]string {
 }
 
 type redirects []struct {
-	Path string `yaml:"path"`
-	URL  string `yaml:"url"`
+	Path string `json:"path"`
+	URL  string `json:"url"`
 }
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJSONHandlerStrictRejectsDuplicatePath(t *testing.T) {
+	jsn := []byte(`[
+		{"path": "/gh", "url": "https://github.com"},
+		{"path": "/gh", "url": "https://github.com/other"}
+	]`)
+
+	_, err := JSONHandlerStrict(jsn, http.NotFoundHandler())
+	if err == nil {
+		t.Fatal("JSONHandlerStrict with a duplicate path = nil error, want a *JSONValidationError")
+	}
+	if _, ok := err.(*JSONValidationError); !ok {
+		t.Fatalf("err = %T, want *JSONValidationError", err)
+	}
+}
+
+func TestJSONHandlerStrictRejectsEmptyURL(t *testing.T) {
+	jsn := []byte(`[{"path": "/gh", "url": ""}]`)
+
+	_, err := JSONHandlerStrict(jsn, http.NotFoundHandler())
+	if err == nil {
+		t.Fatal("JSONHandlerStrict with an empty url = nil error, want a *JSONValidationError")
+	}
+}
+
+func TestJSONHandlerStrictAcceptsValidRedirects(t *testing.T) {
+	jsn := []byte(`[{"path": "/gh", "url": "https://github.com"}]`)
+
+	if _, err := JSONHandlerStrict(jsn, http.NotFoundHandler()); err != nil {
+		t.Fatalf("JSONHandlerStrict: %v", err)
+	}
+}
+
+func TestJSONHandlerAcceptsLegacySingleRedirectObject(t *testing.T) {
+	jsn := []byte(`{"path": "/gh", "url": "https://github.com"}`)
+
+	h, err := JSONHandler(jsn, http.NotFoundHandler())
+	if err != nil {
+		t.Fatalf("JSONHandler: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/gh", nil)
+	rec := httptest.NewRecorder()
+	h(rec, req)
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("GET /gh = %d, want %d", rec.Code, http.StatusSeeOther)
+	}
+}
+
+func TestJSONHandlerRejectsUnrecognizedShape(t *testing.T) {
+	jsn := []byte(`{"foo": "bar"}`)
+
+	if _, err := JSONHandler(jsn, http.NotFoundHandler()); err == nil {
+		t.Fatal("JSONHandler with an unrecognized object shape = nil error, want one")
+	}
+}
+
+func TestLoaderFromFileUnsupportedExtension(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.txt")
+	if err := os.WriteFile(path, []byte("not a redirect file"), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := LoaderFromFile(path, http.NotFoundHandler()); err == nil {
+		t.Fatal("LoaderFromFile(.txt) = nil error, want one about the unsupported extension")
+	}
+}
+
+func TestLoaderFromFileJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.json")
+	data := []byte(`[{"path": "/gh", "url": "https://github.com"}]`)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := LoaderFromFile(path, http.NotFoundHandler()); err != nil {
+		t.Fatalf("LoaderFromFile(.json): %v", err)
+	}
+}
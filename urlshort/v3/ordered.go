@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Redirect is a single declared path -> url mapping.
+type Redirect struct {
+	Path string
+	URL  string
+}
+
+// orderedRedirects preserves the declaration order of a YAML redirects
+// list. A plain `[]struct{...}` would already decode a YAML sequence
+// in order, but OrderedHandler needs that order to pick the
+// longest-declared-prefix match deterministically, so UnmarshalYAML
+// walks the raw *yaml.Node tree explicitly (Content holds each
+// mapping's keys and values as a flat, ordered list, two entries per
+// field) instead of leaning on struct-field decoding.
+type orderedRedirects []Redirect
+
+func (rs *orderedRedirects) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.SequenceNode {
+		return fmt.Errorf("redirects: expected a YAML sequence, got kind %d", value.Kind)
+	}
+
+	out := make(orderedRedirects, 0, len(value.Content))
+	for _, item := range value.Content {
+		if item.Kind != yaml.MappingNode {
+			return fmt.Errorf("redirects: expected a mapping entry, got kind %d", item.Kind)
+		}
+
+		var r Redirect
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			key, val := item.Content[i], item.Content[i+1]
+			switch key.Value {
+			case "path":
+				r.Path = val.Value
+			case "url":
+				r.URL = val.Value
+			}
+		}
+		out = append(out, r)
+	}
+
+	*rs = out
+	return nil
+}
+
+func parseOrderedYAML(yml []byte) (orderedRedirects, error) {
+	var rs orderedRedirects
+	if err := yaml.Unmarshal(yml, &rs); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// OrderedHandler returns an http.HandlerFunc that matches paths
+// against rs in declaration order: an exact match wins first, then
+// the longest declared prefix whose path ends in "/*" is used, with
+// "$1" in its URL substituted by the unmatched remainder of the
+// request path. If nothing matches, fallback is called.
+func OrderedHandler(rs []Redirect, fallback http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, rd := range rs {
+			if rd.Path == r.URL.Path {
+				http.Redirect(w, r, rd.URL, http.StatusSeeOther)
+				return
+			}
+		}
+
+		var best Redirect
+		bestPrefixLen := -1
+		for _, rd := range rs {
+			prefix := strings.TrimSuffix(rd.Path, "/*")
+			if prefix == rd.Path {
+				continue // no trailing glob, already ruled out by the exact-match pass
+			}
+			matches := r.URL.Path == prefix || strings.HasPrefix(r.URL.Path, prefix+"/")
+			if matches && len(prefix) > bestPrefixLen {
+				best = rd
+				bestPrefixLen = len(prefix)
+			}
+		}
+
+		if bestPrefixLen >= 0 {
+			prefix := strings.TrimSuffix(best.Path, "/*")
+			rest := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+			url := strings.ReplaceAll(best.URL, "$1", rest)
+			http.Redirect(w, r, url, http.StatusSeeOther)
+			return
+		}
+
+		fallback.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func fallbackRecorder() (http.Handler, *bool) {
+	hit := false
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+		w.WriteHeader(http.StatusNotFound)
+	}), &hit
+}
+
+func TestOrderedHandlerExactMatch(t *testing.T) {
+	fallback, _ := fallbackRecorder()
+	h := OrderedHandler([]Redirect{{Path: "/gh", URL: "https://github.com"}}, fallback)
+
+	req := httptest.NewRequest(http.MethodGet, "/gh", nil)
+	rec := httptest.NewRecorder()
+	h(rec, req)
+
+	if got := rec.Header().Get("Location"); got != "https://github.com" {
+		t.Fatalf("Location = %q, want %q", got, "https://github.com")
+	}
+}
+
+func TestOrderedHandlerPrefixMatch(t *testing.T) {
+	fallback, _ := fallbackRecorder()
+	rs := []Redirect{{Path: "/articles/*", URL: "https://blog.example.com/$1"}}
+	h := OrderedHandler(rs, fallback)
+
+	req := httptest.NewRequest(http.MethodGet, "/articles/hello-world", nil)
+	rec := httptest.NewRecorder()
+	h(rec, req)
+
+	if got := rec.Header().Get("Location"); got != "https://blog.example.com/hello-world" {
+		t.Fatalf("Location = %q, want %q", got, "https://blog.example.com/hello-world")
+	}
+}
+
+func TestOrderedHandlerPrefixRequiresSegmentBoundary(t *testing.T) {
+	fallback, hit := fallbackRecorder()
+	rs := []Redirect{{Path: "/articles/*", URL: "https://blog.example.com/$1"}}
+	h := OrderedHandler(rs, fallback)
+
+	req := httptest.NewRequest(http.MethodGet, "/articles-unrelated-page", nil)
+	rec := httptest.NewRecorder()
+	h(rec, req)
+
+	if !*hit {
+		t.Fatalf("OrderedHandler matched %q against the /articles/* rule, want fallback", req.URL.Path)
+	}
+	if loc := rec.Header().Get("Location"); loc != "" {
+		t.Fatalf("Location = %q, want no redirect", loc)
+	}
+}
+
+func TestOrderedHandlerFallback(t *testing.T) {
+	fallback, hit := fallbackRecorder()
+	h := OrderedHandler(nil, fallback)
+
+	req := httptest.NewRequest(http.MethodGet, "/nope", nil)
+	rec := httptest.NewRecorder()
+	h(rec, req)
+
+	if !*hit {
+		t.Fatal("OrderedHandler with no rules did not call fallback")
+	}
+}
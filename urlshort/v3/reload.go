@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/volodimyr/vikinGo/urlshort/v3/sources"
+)
+
+// ReloadableHandler returns an http.Handler backed by src that keeps
+// serving the most recently loaded redirect map, swapping it in
+// under a lock every time src reports a change. If a reload fails to
+// parse, the error is logged and the previous map keeps serving.
+func ReloadableHandler(src sources.Source, fallback http.Handler) (http.Handler, error) {
+	pathsToUrls, err := src.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.RWMutex
+
+	go func() {
+		for range src.Watch(context.Background()) {
+			reloaded, err := src.Load()
+			if err != nil {
+				log.Printf("urlshort: reload failed, keeping previous redirects: %v", err)
+				continue
+			}
+			mu.Lock()
+			pathsToUrls = reloaded
+			mu.Unlock()
+		}
+	}()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.RLock()
+		url, exist := pathsToUrls[r.URL.Path]
+		mu.RUnlock()
+		if exist {
+			http.Redirect(w, r, url, http.StatusSeeOther)
+			return
+		}
+		fallback.ServeHTTP(w, r)
+	}), nil
+}
@@ -0,0 +1,128 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// debounceWindow absorbs the write+rename pairs that editors like vim
+// and VS Code emit for a single save, so a single edit doesn't trigger
+// two reloads.
+const debounceWindow = 100 * time.Millisecond
+
+// FileSource loads a JSON or YAML redirect file and watches it on
+// disk with fsnotify, re-parsing whenever it changes.
+type FileSource struct {
+	Path string
+}
+
+// NewFileSource returns a FileSource for the redirect file at path.
+func NewFileSource(path string) *FileSource {
+	return &FileSource{Path: path}
+}
+
+func (s *FileSource) Load() (map[string]string, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rs []struct {
+		Path string `json:"path" yaml:"path"`
+		URL  string `json:"url" yaml:"url"`
+	}
+
+	switch filepath.Ext(s.Path) {
+	case ".json":
+		err = json.Unmarshal(data, &rs)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &rs)
+	default:
+		return nil, fmt.Errorf("unsupported redirect file extension: %s", filepath.Ext(s.Path))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	built := make(map[string]string, len(rs))
+	for _, r := range rs {
+		built[r.Path] = r.URL
+	}
+	return built, nil
+}
+
+func (s *FileSource) Watch(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return
+		}
+		defer watcher.Close()
+
+		if err := watcher.Add(filepath.Dir(s.Path)); err != nil {
+			return
+		}
+
+		// mu guards closed against a debounce.AfterFunc callback firing
+		// concurrently with this goroutine's return, which would
+		// otherwise race the deferred close(events) and send on a
+		// closed channel.
+		var mu sync.Mutex
+		closed := false
+		defer func() {
+			mu.Lock()
+			closed = true
+			mu.Unlock()
+		}()
+
+		send := func() {
+			mu.Lock()
+			defer mu.Unlock()
+			if closed {
+				return
+			}
+			select {
+			case events <- Event{}:
+			case <-ctx.Done():
+			}
+		}
+
+		var debounce *time.Timer
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				_ = err
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(ev.Name) != filepath.Clean(s.Path) {
+					continue
+				}
+				if debounce != nil {
+					debounce.Stop()
+				}
+				debounce = time.AfterFunc(debounceWindow, send)
+			}
+		}
+	}()
+
+	return events
+}
@@ -0,0 +1,95 @@
+package sources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeRedirects(t *testing.T, path, body string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(body), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
+
+func TestFileSourceLoadJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.json")
+	writeRedirects(t, path, `[{"path":"/gh","url":"https://github.com"}]`)
+
+	paths, err := NewFileSource(path).Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if paths["/gh"] != "https://github.com" {
+		t.Fatalf("Load() = %v, want it to contain /gh", paths)
+	}
+}
+
+func TestFileSourceWatchDebouncesRapidWrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.json")
+	writeRedirects(t, path, `[{"path":"/gh","url":"https://github.com"}]`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := NewFileSource(path).Watch(ctx)
+
+	// Simulate the write+rename pair an editor emits for a single
+	// save: two distinct fsnotify events inside the debounce window
+	// should collapse into a single Event.
+	writeRedirects(t, path, `[{"path":"/gh","url":"https://github.com/other"}]`)
+	time.Sleep(debounceWindow / 2)
+	writeRedirects(t, path, `[{"path":"/gh","url":"https://github.com/final"}]`)
+
+	select {
+	case <-events:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the debounced reload event")
+	}
+
+	select {
+	case <-events:
+		t.Fatal("received a second event for writes inside one debounce window")
+	case <-time.After(debounceWindow * 2):
+	}
+}
+
+func TestFileSourceWatchClosesEventsOnContextCancel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.json")
+	writeRedirects(t, path, `[{"path":"/gh","url":"https://github.com"}]`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := NewFileSource(path).Watch(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("events delivered a value after cancellation, want the channel closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for events to close after ctx cancellation")
+	}
+}
+
+// TestFileSourceWatchSurvivesCancelDuringPendingDebounce exercises the
+// mutex-guarded shutdown path fixed in 1bd8ea7: cancelling right after
+// a write schedules a debounce timer must not panic with "send on
+// closed channel" when the timer fires concurrently with close(events).
+func TestFileSourceWatchSurvivesCancelDuringPendingDebounce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.json")
+	writeRedirects(t, path, `[{"path":"/gh","url":"https://github.com"}]`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := NewFileSource(path).Watch(ctx)
+
+	writeRedirects(t, path, `[{"path":"/gh","url":"https://github.com/other"}]`)
+	cancel()
+
+	select {
+	case <-events:
+	case <-time.After(time.Second):
+	}
+}
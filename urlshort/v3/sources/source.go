@@ -0,0 +1,22 @@
+// Package sources provides redirect map sources that can be loaded
+// once and, for sources that support it, watched for changes so a
+// long-lived server can pick up edits without a restart.
+package sources
+
+import "context"
+
+// Event is sent on the channel returned by Source.Watch whenever the
+// underlying redirects may have changed. It carries no payload;
+// receivers should call Load again to get the fresh map.
+type Event struct{}
+
+// Source loads a path-to-URL redirect map and, optionally, watches it
+// for changes.
+type Source interface {
+	// Load reads and parses the current redirects.
+	Load() (map[string]string, error)
+	// Watch returns a channel that receives an Event every time the
+	// source detects a change. The channel is closed when ctx is
+	// done.
+	Watch(ctx context.Context) <-chan Event
+}